@@ -62,6 +62,15 @@ k8s_apply_kustomize() {
   kustomize build ${overlay} | kubectl --context ${kubecontext} apply -f -
 }
 
+k8s_assert_manifest() {
+  local manifests=$1
+  local expression=$2
+  yq --exit-status "$expression" "$manifests" > /dev/null || {
+    echo "Expected ${manifests} to match: ${expression}"
+    return 1
+  }
+}
+
 k8s_destroy_kustomize() {
   local kubecontext=$1
   local overlay=$2